@@ -0,0 +1,2 @@
+ALTER TABLE users ADD COLUMN roles TEXT;
+CREATE INDEX users_status_idx ON users (status);
@@ -0,0 +1,52 @@
+//go:build cgo
+
+package main
+
+/*
+#cgo CFLAGS: -O2 -Wall
+#include <stdlib.h>
+#include <string.h>
+#include <ctype.h>
+
+// Implemented in Go via //export
+extern void goProgress(int step, int total);
+
+static inline void upper_in_place(char *s) {
+	for (; *s != '\0'; s++) {
+		*s = (char)toupper((unsigned char)*s);
+	}
+}
+
+static inline void run_steps(int total) {
+	for (int i = 1; i <= total; i++) {
+		goProgress(i, total);
+	}
+}
+*/
+import "C"
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+// Call C with a Go string
+func CUpper(s string) string {
+	cs := C.CString(s)
+	defer C.free(unsafe.Pointer(cs))
+
+	C.upper_in_place(cs)
+	return C.GoStringN(cs, C.int(C.strlen(cs)))
+}
+
+// Exported Go callback invoked from C
+//
+//export goProgress
+func goProgress(step, total C.int) {
+	fmt.Printf("step %d/%d\n", int(step), int(total))
+}
+
+// Drive a C loop that calls back into Go
+func RunSteps(total int) {
+	C.run_steps(C.int(total))
+}
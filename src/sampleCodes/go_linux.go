@@ -0,0 +1,51 @@
+//go:build linux
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// Raise the soft open-file limit, capped at the hard limit
+func RaiseFileLimit(want uint64) (uint64, error) {
+	var rlim unix.Rlimit
+	if err := unix.Getrlimit(unix.RLIMIT_NOFILE, &rlim); err != nil {
+		return 0, fmt.Errorf("getrlimit: %w", err)
+	}
+	if rlim.Cur >= want {
+		return rlim.Cur, nil
+	}
+
+	rlim.Cur = min(want, rlim.Max)
+	if err := unix.Setrlimit(unix.RLIMIT_NOFILE, &rlim); err != nil {
+		return 0, fmt.Errorf("setrlimit(RLIMIT_NOFILE, %d): %w", rlim.Cur, err)
+	}
+	return rlim.Cur, nil
+}
+
+// Non-blocking exclusive advisory lock held until unlock is called
+func LockFile(f *os.File) (unlock func() error, err error) {
+	fd := int(f.Fd())
+	for {
+		err = unix.Flock(fd, unix.LOCK_EX|unix.LOCK_NB)
+		if !errors.Is(err, unix.EINTR) {
+			break
+		}
+	}
+
+	var errno unix.Errno
+	switch {
+	case err == nil:
+		return func() error { return unix.Flock(fd, unix.LOCK_UN) }, nil
+	case errors.Is(err, unix.EWOULDBLOCK):
+		return nil, fmt.Errorf("%s: %w", f.Name(), ErrLocked)
+	case errors.As(err, &errno):
+		return nil, fmt.Errorf("flock %s: %s (%s, errno %d)", f.Name(), errno, unix.ErrnoName(errno), uintptr(errno))
+	default:
+		return nil, err
+	}
+}
@@ -0,0 +1,18 @@
+//go:build !linux
+
+package main
+
+import (
+	"errors"
+	"os"
+)
+
+// Resource limits are only adjusted on Linux
+func RaiseFileLimit(want uint64) (uint64, error) {
+	return 0, errors.ErrUnsupported
+}
+
+// File locking is only implemented on Linux
+func LockFile(f *os.File) (unlock func() error, err error) {
+	return nil, errors.ErrUnsupported
+}
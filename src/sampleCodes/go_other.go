@@ -0,0 +1,17 @@
+//go:build !(linux || darwin || freebsd) && !windows
+
+package main
+
+import "os"
+
+// Platform name
+const platformName = "other"
+
+// Fallback configuration directory
+func configDir() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return os.TempDir()
+	}
+	return dir
+}
@@ -0,0 +1,302 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+// Table-driven test
+func TestSum(t *testing.T) {
+	tests := []struct {
+		name    string
+		numbers []int
+		want    int
+	}{
+		{name: "empty", numbers: nil, want: 0},
+		{name: "single", numbers: []int{42}, want: 42},
+		{name: "multiple", numbers: []int{1, 2, 3, 4, 5}, want: 15},
+		{name: "negative", numbers: []int{-10, 5, -3}, want: -8},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Sum(tt.numbers...); got != tt.want {
+				t.Errorf("Sum(%v) = %d, want %d", tt.numbers, got, tt.want)
+			}
+		})
+	}
+}
+
+// Table-driven test with error cases
+func TestDivide(t *testing.T) {
+	tests := []struct {
+		name    string
+		a, b    float64
+		want    float64
+		wantErr error
+	}{
+		{name: "integer result", a: 10, b: 2, want: 5},
+		{name: "fractional result", a: 1, b: 3, want: 0.3333333333},
+		{name: "negative divisor", a: 9, b: -3, want: -3},
+		{name: "division by zero", a: 1, b: 0, wantErr: ErrDivisionByZero},
+	}
+
+	const epsilon = 1e-9
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Divide(tt.a, tt.b)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Divide(%v, %v) error = %v, want %v", tt.a, tt.b, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Divide(%v, %v) unexpected error: %v", tt.a, tt.b, err)
+			}
+			if diff := math.Abs(got - tt.want); diff > epsilon {
+				t.Errorf("Divide(%v, %v) = %v, want %v (diff %g)", tt.a, tt.b, got, tt.want, diff)
+			}
+		})
+	}
+}
+
+// Parallel subtests
+func TestValidateEmail(t *testing.T) {
+	tests := map[string]struct {
+		email string
+		want  bool
+	}{
+		"simple":         {email: "alice@example.com", want: true},
+		"plus tag":       {email: "bob+zenn@example.co.jp", want: true},
+		"missing at":     {email: "alice.example.com", want: false},
+		"missing domain": {email: "alice@", want: false},
+		"short tld":      {email: "alice@example.c", want: false},
+		"empty":          {email: "", want: false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			if got := ValidateEmail(tt.email); got != tt.want {
+				t.Errorf("ValidateEmail(%q) = %t, want %t", tt.email, got, tt.want)
+			}
+		})
+	}
+}
+
+// Requests without a cookie must not grow the session store
+func TestSessionStoreBounded(t *testing.T) {
+	store := NewSessionStore([]byte("test-key"), time.Hour)
+	handler := store.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s, _ := SessionFrom(r.Context())
+		if r.URL.Path == "/login" {
+			s.Set("user", "alice")
+		}
+	}))
+
+	const n = 100
+	for range n {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/health", nil))
+		if cookies := rec.Result().Cookies(); len(cookies) != 0 {
+			t.Fatalf("read-only request set %d cookies", len(cookies))
+		}
+	}
+	if got := store.Len(); got != 0 {
+		t.Fatalf("after %d cookie-less reads: store holds %d sessions, want 0", n, got)
+	}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/login", nil))
+	cookies := rec.Result().Cookies()
+	if len(cookies) != 1 || store.Len() != 1 {
+		t.Fatalf("first write: cookies=%d sessions=%d, want 1 and 1", len(cookies), store.Len())
+	}
+
+	// The returning client reuses its session instead of creating another
+	for range n {
+		req := httptest.NewRequest(http.MethodGet, "/login", nil)
+		req.AddCookie(cookies[0])
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+	}
+	if got := store.Len(); got != 1 {
+		t.Errorf("returning client: store holds %d sessions, want 1", got)
+	}
+}
+
+// POST /users responds with the stored user, not the request body
+func TestCreateUserRespondsWithStoredUser(t *testing.T) {
+	repo := NewInMemoryRepository[User]()
+	_ = repo.Save(context.Background(), User{Name: "Alice", Email: "alice@example.com"})
+	router := NewRouter(repo)
+
+	rec := httptest.NewRecorder()
+	body := strings.NewReader(`{"id": 99, "name": "Bob", "email": "bob@example.com"}`)
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/users", body))
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("status = %d, want %d: %s", rec.Code, http.StatusCreated, rec.Body)
+	}
+
+	var got User
+	if err := json.NewDecoder(rec.Body).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	if got.ID != 2 || got.CreatedAt.IsZero() {
+		t.Errorf("response id=%d created_at=%v, want id 2 and a creation time", got.ID, got.CreatedAt)
+	}
+	if stored, err := repo.FindByID(context.Background(), got.ID); err != nil || stored.Name != "Bob" {
+		t.Errorf("FindByID(%d) = %v, %v", got.ID, stored, err)
+	}
+}
+
+// Exported entry names must survive ExtractZip whatever the user is called
+func TestExportUsersZipHostileNames(t *testing.T) {
+	users := []User{
+		{Entity: Entity{ID: 1}, Name: "../../etc/passwd"},
+		{Entity: Entity{ID: 2}, Name: `C:\Windows`},
+		{Entity: Entity{ID: 3}, Name: "/"},
+	}
+	data, err := ExportUsersZip(users)
+	if err != nil {
+		t.Fatal(err)
+	}
+	n, err := ExtractZip(data, t.TempDir())
+	if err != nil || n != len(users)+1 {
+		t.Fatalf("ExtractZip = %d, %v; want %d files", n, err, len(users)+1)
+	}
+}
+
+// Benchmark with setup excluded from timing
+func BenchmarkInMemoryRepositorySave(b *testing.B) {
+	ctx := context.Background()
+	repo := NewInMemoryRepository[User]()
+	user := User{Name: "Alice", Email: "alice@example.com"}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := repo.Save(ctx, user); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// Parallel benchmark
+func BenchmarkInMemoryRepositoryFindByID(b *testing.B) {
+	ctx := context.Background()
+	repo := NewInMemoryRepository[User]()
+	for i := 0; i < 1000; i++ {
+		_ = repo.Save(ctx, User{Name: fmt.Sprintf("user-%d", i)})
+	}
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		var id int64
+		for pb.Next() {
+			id = id%1000 + 1
+			if _, err := repo.FindByID(ctx, id); err != nil {
+				b.Error(err)
+			}
+		}
+	})
+}
+
+// Sub-benchmarks over input sizes
+func BenchmarkProcessItems(b *testing.B) {
+	for _, size := range []int{10, 100, 1_000, 10_000} {
+		items := make([]int, size)
+		for i := range items {
+			items[i] = i
+		}
+
+		b.Run(fmt.Sprintf("size=%d", size), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				for range ProcessItems(context.Background(), items, 4) {
+				}
+			}
+			b.ReportMetric(float64(size*b.N)/b.Elapsed().Seconds(), "items/s")
+		})
+	}
+}
+
+// Fuzz test with seed corpus
+func FuzzValidateEmail(f *testing.F) {
+	seeds := []string{"alice@example.com", "bob+zenn@example.co.jp", "no-at-sign", "@", ""}
+	for _, seed := range seeds {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, email string) {
+		if !ValidateEmail(email) {
+			return
+		}
+		if !utf8.ValidString(email) {
+			t.Errorf("accepted invalid UTF-8: %q", email)
+		}
+		local, domain, ok := strings.Cut(email, "@")
+		if !ok || local == "" || !strings.Contains(domain, ".") {
+			t.Errorf("accepted malformed address: %q", email)
+		}
+	})
+}
+
+// Fuzz test with multiple arguments
+func FuzzDivide(f *testing.F) {
+	f.Add(10.0, 2.0)
+	f.Add(1.0, 0.0)
+	f.Add(-7.5, 0.25)
+
+	f.Fuzz(func(t *testing.T, a, b float64) {
+		got, err := Divide(a, b)
+		if b == 0 {
+			if !errors.Is(err, ErrDivisionByZero) {
+				t.Fatalf("Divide(%v, 0) error = %v, want ErrDivisionByZero", a, err)
+			}
+			return
+		}
+		if err != nil {
+			t.Fatalf("Divide(%v, %v) unexpected error: %v", a, b, err)
+		}
+		if math.IsNaN(got) && !math.IsNaN(a) && !math.IsNaN(b) && !math.IsInf(a, 0) {
+			t.Errorf("Divide(%v, %v) = NaN", a, b)
+		}
+	})
+}
+
+// Testable examples
+func ExampleSum() {
+	fmt.Println(Sum(1, 2, 3))
+	fmt.Println(Sum())
+	// Output:
+	// 6
+	// 0
+}
+
+func ExampleStatus_String() {
+	for _, s := range []Status{StatusPending, StatusCompleted, Status(42)} {
+		fmt.Println(s)
+	}
+	// Output:
+	// pending
+	// completed
+	// Status(42)
+}
+
+func ExampleFilter() {
+	evens := Filter([]int{1, 2, 3, 4, 5, 6}, func(n int) bool {
+		return n%2 == 0
+	})
+	fmt.Println(evens)
+	// Output: [2 4 6]
+}
@@ -0,0 +1,19 @@
+//go:build linux || darwin || freebsd
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// Platform name
+const platformName = "unix"
+
+// Configuration directory following the XDG convention
+func configDir() string {
+	if dir := os.Getenv("XDG_CONFIG_HOME"); dir != "" {
+		return dir
+	}
+	return filepath.Join(os.Getenv("HOME"), ".config")
+}
@@ -0,0 +1,13 @@
+//go:build windows
+
+package main
+
+import "os"
+
+// Platform name
+const platformName = "windows"
+
+// Configuration directory under the roaming profile
+func configDir() string {
+	return os.Getenv("APPDATA")
+}